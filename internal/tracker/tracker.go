@@ -0,0 +1,34 @@
+// Package tracker counts scan progress for reporting in the final summary.
+package tracker
+
+import "sync/atomic"
+
+// CITracker counts scan progress. Its Inc* methods are safe to call from
+// multiple goroutines; the exported fields remain plain int64s so existing
+// callers can keep reading them directly once a scan has finished.
+type CITracker struct {
+	FoundFiles      int64
+	ParsedFiles     int64
+	LoadedQueries   int64
+	ExecutedQueries int64
+}
+
+// IncFoundFiles atomically increments FoundFiles by one.
+func (t *CITracker) IncFoundFiles() {
+	atomic.AddInt64(&t.FoundFiles, 1)
+}
+
+// IncParsedFiles atomically increments ParsedFiles by one.
+func (t *CITracker) IncParsedFiles() {
+	atomic.AddInt64(&t.ParsedFiles, 1)
+}
+
+// AddLoadedQueries atomically adds n to LoadedQueries.
+func (t *CITracker) AddLoadedQueries(n int64) {
+	atomic.AddInt64(&t.LoadedQueries, n)
+}
+
+// IncExecutedQueries atomically increments ExecutedQueries by one.
+func (t *CITracker) IncExecutedQueries() {
+	atomic.AddInt64(&t.ExecutedQueries, 1)
+}
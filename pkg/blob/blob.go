@@ -0,0 +1,130 @@
+// Package blob abstracts the locations kics reads queries and source files
+// from, and writes reports and payloads to, behind a single Storage
+// interface so `file://`, `s3://`, `gs://`, and `http(s)://` locators can be
+// used interchangeably with plain local paths.
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Storage is a minimal key-value blob store. Keys are slash-separated paths
+// relative to the bucket/prefix (or directory, for the local backend) the
+// Storage was opened against.
+type Storage interface {
+	// Get opens key for reading. Callers must close the returned reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Put writes the contents of r to key, creating or overwriting it.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Walk calls fn once per key found under prefix.
+	Walk(ctx context.Context, prefix string, fn func(key string) error) error
+}
+
+// New opens a Storage rooted at rawURL, dispatching on its scheme:
+//
+//	(no scheme) or file://  -> local filesystem
+//	s3://bucket/prefix      -> Amazon S3
+//	gs://bucket/prefix      -> Google Cloud Storage
+//	http(s)://host/prefix   -> read-only HTTP(S)
+//
+// rawURL is treated as a directory/prefix: keys passed to Get/Put/Walk are
+// resolved relative to it. Use PutObject/GetObject instead when rawURL
+// names a single object.
+func New(ctx context.Context, rawURL string) (Storage, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse location %q: %w", rawURL, err)
+	}
+
+	switch u.Scheme {
+	case "":
+		return newLocalStorage(rawURL)
+	case "file":
+		return newLocalStorage(u.Path)
+	case "s3":
+		return newS3Storage(ctx, u.Host, u.Path)
+	case "gs":
+		return newGCSStorage(ctx, u.Host, u.Path)
+	case "http", "https":
+		return newHTTPStorage(u)
+	default:
+		return nil, fmt.Errorf("unsupported storage scheme %q in location %q", u.Scheme, rawURL)
+	}
+}
+
+// LocalPath reports whether rawURL names a location on the local
+// filesystem — a bare path, or a file:// locator — and, if so, returns the
+// plain path to use with the os package.
+func LocalPath(rawURL string) (localPath string, ok bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL, true
+	}
+
+	switch u.Scheme {
+	case "":
+		return rawURL, true
+	case "file":
+		return u.Path, true
+	default:
+		return "", false
+	}
+}
+
+// GetObject opens the single object rawURL names, independent of the
+// directory/prefix semantics New assumes: the store is rooted at rawURL's
+// parent and the final path segment is used as the key.
+func GetObject(ctx context.Context, rawURL string) (io.ReadCloser, error) {
+	storeURL, key, err := splitObject(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := New(ctx, storeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.Get(ctx, key)
+}
+
+// PutObject writes r to the single object rawURL names, independent of the
+// directory/prefix semantics New assumes: the store is rooted at rawURL's
+// parent and the final path segment is used as the key.
+func PutObject(ctx context.Context, rawURL string, r io.Reader) error {
+	storeURL, key, err := splitObject(rawURL)
+	if err != nil {
+		return err
+	}
+
+	store, err := New(ctx, storeURL)
+	if err != nil {
+		return err
+	}
+
+	return store.Put(ctx, key, r)
+}
+
+// splitObject splits rawURL into the URL of the directory/prefix containing
+// it (suitable for New) and the final path segment (suitable as a Storage
+// key), preserving rawURL's scheme and host.
+func splitObject(rawURL string) (storeURL, key string, err error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse location %q: %w", rawURL, err)
+	}
+
+	dir, base := path.Split(strings.TrimSuffix(u.Path, "/"))
+	if base == "" {
+		return "", "", fmt.Errorf("location %q does not name an object", rawURL)
+	}
+
+	u.Path = dir
+
+	return u.String(), base, nil
+}
@@ -0,0 +1,46 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// FetchToDir downloads every key under prefix from storage into destDir,
+// skipping keys present in exclude. It is used to pull a remote query
+// bundle or source tree into a local workspace that the existing
+// filesystem-based providers can scan unchanged.
+func FetchToDir(ctx context.Context, store Storage, prefix, destDir string, exclude []string) error {
+	excluded := make(map[string]bool, len(exclude))
+	for _, e := range exclude {
+		excluded[e] = true
+	}
+
+	return store.Walk(ctx, prefix, func(key string) error {
+		if excluded[key] {
+			return nil
+		}
+
+		r, err := store.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		dest := filepath.Join(destDir, filepath.FromSlash(key))
+		if err := os.MkdirAll(filepath.Dir(dest), os.ModePerm); err != nil {
+			return err
+		}
+
+		f, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, os.ModePerm)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(f, r)
+
+		return err
+	})
+}
@@ -0,0 +1,72 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// gcsStorage backs Storage with a Google Cloud Storage bucket/prefix.
+type gcsStorage struct {
+	client *storage.Client
+	bucket string
+	prefix string
+}
+
+func newGCSStorage(ctx context.Context, bucket, prefix string) (Storage, error) {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gcsStorage{
+		client: client,
+		bucket: bucket,
+		prefix: strings.Trim(prefix, "/"),
+	}, nil
+}
+
+func (s *gcsStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.client.Bucket(s.bucket).Object(s.key(key)).NewReader(ctx)
+}
+
+func (s *gcsStorage) Put(ctx context.Context, key string, r io.Reader) error {
+	w := s.client.Bucket(s.bucket).Object(s.key(key)).NewWriter(ctx)
+
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close() //nolint:errcheck
+		return err
+	}
+
+	return w.Close()
+}
+
+func (s *gcsStorage) Walk(ctx context.Context, prefix string, fn func(key string) error) error {
+	it := s.client.Bucket(s.bucket).Objects(ctx, &storage.Query{Prefix: s.key(prefix)})
+
+	for {
+		obj, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		rel := strings.TrimPrefix(obj.Name, s.prefix+"/")
+		if err := fn(rel); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *gcsStorage) key(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+
+	return s.prefix + "/" + strings.TrimPrefix(key, "/")
+}
@@ -0,0 +1,69 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// httpStorage backs Storage with a single read-only HTTP(S) object. Plain
+// HTTP has no standard way to list a directory, so Walk reports only the
+// root object, and Put is unsupported.
+type httpStorage struct {
+	base *url.URL
+	// rootKey is the key Walk reports for base itself (its basename), so
+	// Get can recognize it and fetch base as-is instead of joining it onto
+	// its own path a second time.
+	rootKey string
+}
+
+func newHTTPStorage(base *url.URL) (Storage, error) {
+	baseCopy := *base
+
+	return &httpStorage{base: &baseCopy, rootKey: path.Base(base.Path)}, nil
+}
+
+// Get fetches base/key over HTTP(S). An empty key, or the rootKey Walk
+// reports for base itself, fetches base as-is.
+func (s *httpStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	u := *s.base
+	if key != "" && key != s.rootKey {
+		u.Path = path.Join(u.Path, key)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("GET %s: unexpected status %s", u.String(), resp.Status)
+	}
+
+	return resp.Body, nil
+}
+
+func (s *httpStorage) Put(_ context.Context, _ string, _ io.Reader) error {
+	return errors.New("blob: http(s) storage is read-only")
+}
+
+// Walk only supports the root object itself, since HTTP has no standard
+// directory-listing protocol.
+func (s *httpStorage) Walk(_ context.Context, prefix string, fn func(key string) error) error {
+	if prefix != "" {
+		return errors.New("blob: http(s) storage does not support directory listing")
+	}
+
+	return fn(s.rootKey)
+}
@@ -0,0 +1,68 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"testing"
+)
+
+func TestLocalStorageRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := newLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("newLocalStorage() error = %v", err)
+	}
+
+	files := map[string]string{
+		"report.json":         `{"ok":true}`,
+		"nested/payload.json": `{"nested":true}`,
+	}
+
+	for key, content := range files {
+		if err := store.Put(ctx, key, bytes.NewBufferString(content)); err != nil {
+			t.Fatalf("Put(%q) error = %v", key, err)
+		}
+	}
+
+	for key, want := range files {
+		r, err := store.Get(ctx, key)
+		if err != nil {
+			t.Fatalf("Get(%q) error = %v", key, err)
+		}
+
+		got, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatalf("reading %q: %v", key, err)
+		}
+
+		if string(got) != want {
+			t.Errorf("Get(%q) = %q, want %q", key, got, want)
+		}
+	}
+
+	var walked []string
+	if err := store.Walk(ctx, "", func(key string) error {
+		walked = append(walked, key)
+
+		return nil
+	}); err != nil {
+		t.Fatalf("Walk() error = %v", err)
+	}
+
+	sort.Strings(walked)
+
+	want := []string{"nested/payload.json", "report.json"}
+	if len(walked) != len(want) {
+		t.Fatalf("Walk() found %v, want %v", walked, want)
+	}
+
+	for i := range want {
+		if walked[i] != want[i] {
+			t.Errorf("Walk()[%d] = %q, want %q", i, walked[i], want[i])
+		}
+	}
+}
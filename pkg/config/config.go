@@ -0,0 +1,136 @@
+// Package config assembles the settings for a scan from, in increasing
+// order of precedence: built-in defaults, an optional config file,
+// KICS_* environment variables, and command-line flags. Cobra owns flag
+// parsing, so callers apply flags on top of the Config returned by Load.
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/checkmarxDev/ice/pkg/model"
+)
+
+// Config is the full set of settings governing a scan.
+type Config struct {
+	Path        string   `yaml:"path"`
+	QueriesPath string   `yaml:"queries_path"`
+	OutputPath  string   `yaml:"output_path"`
+	PayloadPath string   `yaml:"payload_path"`
+	Workers     int      `yaml:"workers"`
+	Reporters   []string `yaml:"reporters"`
+
+	MinSeverity    model.Severity   `yaml:"min_severity"`
+	IncludeQueries []string         `yaml:"include_queries"`
+	ExcludeQueries []string         `yaml:"exclude_queries"`
+	IncludePaths   []string         `yaml:"include_paths"`
+	ExcludePaths   []string         `yaml:"exclude_paths"`
+	FailOn         []model.Severity `yaml:"fail_on"`
+}
+
+// Default returns a Config populated with kics' built-in defaults.
+func Default() Config {
+	return Config{
+		QueriesPath: "./assets/queries",
+		Workers:     runtime.NumCPU(),
+		Reporters:   []string{"text"},
+		MinSeverity: model.SeverityInfo,
+		FailOn:      []model.Severity{model.SeverityHigh, model.SeverityMedium, model.SeverityLow},
+	}
+}
+
+// Load builds a Config starting from Default, then overlaying the config
+// file (explicit path, falling back to $KICS_CONFIG) and KICS_*
+// environment variables, in that order.
+func Load(path string) (Config, error) {
+	cfg := Default()
+
+	if path == "" {
+		path = os.Getenv("KICS_CONFIG")
+	}
+
+	if path != "" {
+		if err := loadFile(path, &cfg); err != nil {
+			return cfg, err
+		}
+	}
+
+	loadEnv(&cfg)
+
+	return cfg, nil
+}
+
+func loadFile(path string, cfg *Config) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return yaml.Unmarshal(data, cfg)
+}
+
+func loadEnv(cfg *Config) {
+	setString(&cfg.Path, "KICS_PATH")
+	setString(&cfg.QueriesPath, "KICS_QUERIES_PATH")
+	setString(&cfg.OutputPath, "KICS_OUTPUT_PATH")
+	setString(&cfg.PayloadPath, "KICS_PAYLOAD_PATH")
+
+	if v, ok := os.LookupEnv("KICS_WORKERS"); ok {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Workers = n
+		}
+	}
+
+	setStringSlice(&cfg.Reporters, "KICS_REPORTERS")
+	setStringSlice(&cfg.IncludeQueries, "KICS_INCLUDE_QUERIES")
+	setStringSlice(&cfg.ExcludeQueries, "KICS_EXCLUDE_QUERIES")
+	setStringSlice(&cfg.IncludePaths, "KICS_INCLUDE_PATHS")
+	setStringSlice(&cfg.ExcludePaths, "KICS_EXCLUDE_PATHS")
+
+	if v, ok := os.LookupEnv("KICS_MIN_SEVERITY"); ok {
+		cfg.MinSeverity = model.Severity(strings.ToUpper(v))
+	}
+
+	if v, ok := os.LookupEnv("KICS_FAIL_ON"); ok {
+		cfg.FailOn = parseSeverities(v)
+	}
+}
+
+func setString(dst *string, env string) {
+	if v, ok := os.LookupEnv(env); ok {
+		*dst = v
+	}
+}
+
+func setStringSlice(dst *[]string, env string) {
+	if v, ok := os.LookupEnv(env); ok {
+		*dst = splitCSV(v)
+	}
+}
+
+func splitCSV(v string) []string {
+	parts := strings.Split(v, ",")
+	items := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			items = append(items, p)
+		}
+	}
+
+	return items
+}
+
+func parseSeverities(v string) []model.Severity {
+	parts := splitCSV(v)
+	severities := make([]model.Severity, 0, len(parts))
+	for _, p := range parts {
+		severities = append(severities, model.Severity(strings.ToUpper(p)))
+	}
+
+	return severities
+}
@@ -0,0 +1,75 @@
+package config
+
+import "github.com/checkmarxDev/ice/pkg/model"
+
+// severityRank orders severities from least to most critical, giving
+// MinSeverity and FailOn comparisons a total order to work with.
+var severityRank = map[model.Severity]int{
+	model.SeverityInfo:   0,
+	model.SeverityLow:    1,
+	model.SeverityMedium: 2,
+	model.SeverityHigh:   3,
+}
+
+// FilterSummary returns a copy of summary whose FailedQueries have been
+// narrowed to MinSeverity and, if set, IncludeQueries/ExcludeQueries.
+func (c Config) FilterSummary(summary model.Summary) model.Summary {
+	include := toSet(c.IncludeQueries)
+	exclude := toSet(c.ExcludeQueries)
+
+	filtered := summary.FailedQueries[:0:0]
+	for _, q := range summary.FailedQueries {
+		if severityRank[q.Severity] < severityRank[c.MinSeverity] {
+			continue
+		}
+		if len(include) > 0 && !include[q.QueryName] {
+			continue
+		}
+		if exclude[q.QueryName] {
+			continue
+		}
+
+		filtered = append(filtered, q)
+	}
+
+	summary.FailedQueries = filtered
+
+	return summary
+}
+
+// ExitCode returns 1 if summary contains a finding at or above the lowest
+// severity in FailOn, 0 otherwise. An empty FailOn falls back to "exit 1 if
+// any query failed", matching the previous behaviour.
+func (c Config) ExitCode(summary model.Summary) int {
+	if len(c.FailOn) == 0 {
+		if len(summary.FailedQueries) > 0 {
+			return 1
+		}
+
+		return 0
+	}
+
+	threshold := severityRank[c.FailOn[0]]
+	for _, s := range c.FailOn[1:] {
+		if rank := severityRank[s]; rank < threshold {
+			threshold = rank
+		}
+	}
+
+	for _, q := range summary.FailedQueries {
+		if severityRank[q.Severity] >= threshold {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func toSet(items []string) map[string]bool {
+	set := make(map[string]bool, len(items))
+	for _, i := range items {
+		set[i] = true
+	}
+
+	return set
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/checkmarxDev/ice/pkg/model"
+)
+
+func summaryWith(severities ...model.Severity) model.Summary {
+	queries := make([]model.FailedQuery, len(severities))
+	for i, s := range severities {
+		queries[i] = model.FailedQuery{
+			QueryName: string(s) + "_query",
+			Severity:  s,
+			Files:     []model.FileMatch{{FileName: "main.tf", Line: i + 1}},
+		}
+	}
+
+	return model.Summary{FailedQueries: queries}
+}
+
+func TestFilterSummary(t *testing.T) {
+	summary := summaryWith(model.SeverityInfo, model.SeverityLow, model.SeverityMedium, model.SeverityHigh)
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantLen int
+	}{
+		{"no filters keeps everything", Config{}, 4},
+		{"min severity drops below threshold", Config{MinSeverity: model.SeverityMedium}, 2},
+		{
+			"include queries narrows to the named query",
+			Config{IncludeQueries: []string{"HIGH_query"}},
+			1,
+		},
+		{
+			"exclude queries removes the named query",
+			Config{ExcludeQueries: []string{"HIGH_query"}},
+			3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered := tt.cfg.FilterSummary(summary)
+			if len(filtered.FailedQueries) != tt.wantLen {
+				t.Errorf("FilterSummary() len = %d, want %d", len(filtered.FailedQueries), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		summary model.Summary
+		want    int
+	}{
+		{
+			name:    "empty FailOn exits 1 on any failed query",
+			cfg:     Config{},
+			summary: summaryWith(model.SeverityInfo),
+			want:    1,
+		},
+		{
+			name:    "empty FailOn exits 0 with no failed queries",
+			cfg:     Config{},
+			summary: model.Summary{},
+			want:    0,
+		},
+		{
+			name:    "FailOn omitting the highest severity still catches it",
+			cfg:     Config{FailOn: []model.Severity{model.SeverityMedium}},
+			summary: summaryWith(model.SeverityHigh),
+			want:    1,
+		},
+		{
+			name:    "FailOn threshold not reached exits 0",
+			cfg:     Config{FailOn: []model.Severity{model.SeverityHigh}},
+			summary: summaryWith(model.SeverityMedium, model.SeverityLow),
+			want:    0,
+		},
+		{
+			name:    "FailOn threshold met by the lowest listed severity",
+			cfg:     Config{FailOn: []model.Severity{model.SeverityHigh, model.SeverityMedium}},
+			summary: summaryWith(model.SeverityMedium),
+			want:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.ExitCode(tt.summary); got != tt.want {
+				t.Errorf("ExitCode() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
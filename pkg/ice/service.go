@@ -0,0 +1,192 @@
+// Package ice wires file discovery, parsing and query inspection together
+// to run a scan.
+package ice
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/checkmarxDev/ice/pkg/model"
+)
+
+// SourceProvider discovers the files that make up a scan and streams them
+// on the returned channel. The channel is closed once discovery finishes
+// or ctx is cancelled.
+type SourceProvider interface {
+	Produce(ctx context.Context) (<-chan model.FileMetadata, error)
+}
+
+// Parser turns a raw source file into zero or more parsed documents.
+type Parser interface {
+	Parse(file model.FileMetadata) (model.Document, error)
+}
+
+// Inspector runs queries against a single parsed document.
+type Inspector interface {
+	Inspect(ctx context.Context, scanID string, document model.Document) ([]model.Vulnerability, error)
+}
+
+// Storage persists parsed files and the vulnerabilities found in them.
+type Storage interface {
+	SaveFile(ctx context.Context, scanID string, file model.FileMetadata) error
+	SaveVulnerabilities(ctx context.Context, scanID string, vulnerabilities []model.Vulnerability) error
+}
+
+// Tracker records scan progress; implementations must be safe for
+// concurrent use, since Service drives them from multiple goroutines.
+type Tracker interface {
+	IncFoundFiles()
+	IncParsedFiles()
+	IncExecutedQueries()
+}
+
+// Service runs a full scan: discover files, parse them, inspect the parsed
+// documents, and persist both the documents and any vulnerabilities found.
+type Service struct {
+	SourceProvider SourceProvider
+	Storage        Storage
+	Parser         Parser
+	Inspector      Inspector
+	Tracker        Tracker
+
+	// Workers caps the number of goroutines used for parsing, and
+	// separately for inspection. Defaults to runtime.NumCPU() when <= 0.
+	Workers int
+
+	// FailFast stops the scan on the first parse error instead of
+	// skipping the file and continuing.
+	FailFast bool
+
+	// Logger receives structured, per-stage scan telemetry: scan_id, file
+	// and duration_ms fields around parsing, and duration_ms/vulnerabilities
+	// around inspection. It defaults to the global zerolog logger when left
+	// zero.
+	//
+	// This only covers the records Service itself writes: the Parser,
+	// Inspector and SourceProvider it calls are not handed this logger, so
+	// their own log records carry none of these fields.
+	Logger *zerolog.Logger
+}
+
+// logger returns s.Logger if set, falling back to the global logger.
+func (s *Service) logger() *zerolog.Logger {
+	if s.Logger != nil {
+		return s.Logger
+	}
+
+	return &log.Logger
+}
+
+// StartScan discovers, parses and inspects every file the SourceProvider
+// produces, writing parsed files and vulnerabilities to Storage as they
+// become available. Parsing and inspection each run on their own pool of
+// Workers goroutines; the first fatal error cancels every goroutine still
+// in flight.
+func (s *Service) StartScan(ctx context.Context, scanID string) error {
+	workers := s.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	scanLogger := s.logger().With().Str("scan_id", scanID).Logger()
+	scanLogger.Info().Int("workers", workers).Msg("starting scan")
+
+	group, ctx := errgroup.WithContext(ctx)
+
+	files, err := s.SourceProvider.Produce(ctx)
+	if err != nil {
+		return err
+	}
+
+	documents := make(chan model.Document)
+
+	// storageMu serializes every call into Storage: both pools below write
+	// to it concurrently, and Storage implementations are not assumed to be
+	// safe for concurrent use on their own.
+	var storageMu sync.Mutex
+
+	var parseWG sync.WaitGroup
+	parseWG.Add(workers)
+	for i := 0; i < workers; i++ {
+		group.Go(func() error {
+			defer parseWG.Done()
+
+			for file := range files {
+				s.Tracker.IncFoundFiles()
+
+				start := time.Now()
+				document, parseErr := s.Parser.Parse(file)
+				fileLogger := scanLogger.With().Interface("file", file).Logger()
+
+				if parseErr != nil {
+					fileLogger.Warn().Err(parseErr).Msg("failed to parse file")
+					if s.FailFast {
+						return parseErr
+					}
+
+					continue
+				}
+
+				fileLogger.Debug().Dur("duration_ms", time.Since(start)).Msg("parsed file")
+
+				s.Tracker.IncParsedFiles()
+
+				storageMu.Lock()
+				err := s.Storage.SaveFile(ctx, scanID, file)
+				storageMu.Unlock()
+				if err != nil {
+					return err
+				}
+
+				select {
+				case documents <- document:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+
+			return nil
+		})
+	}
+
+	go func() {
+		parseWG.Wait()
+		close(documents)
+	}()
+
+	for i := 0; i < workers; i++ {
+		group.Go(func() error {
+			for document := range documents {
+				start := time.Now()
+				vulnerabilities, err := s.Inspector.Inspect(ctx, scanID, document)
+				if err != nil {
+					return err
+				}
+
+				scanLogger.Debug().
+					Dur("duration_ms", time.Since(start)).
+					Int("vulnerabilities", len(vulnerabilities)).
+					Msg("inspected document")
+
+				s.Tracker.IncExecutedQueries()
+
+				storageMu.Lock()
+				err = s.Storage.SaveVulnerabilities(ctx, scanID, vulnerabilities)
+				storageMu.Unlock()
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	return group.Wait()
+}
@@ -0,0 +1,83 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/checkmarxDev/ice/pkg/model"
+)
+
+// GithubActionsReporter streams GitHub Actions workflow commands to w (one
+// per finding, grouped per query) and, if GITHUB_STEP_SUMMARY is set,
+// appends a Markdown summary table.
+type GithubActionsReporter struct{}
+
+// Report writes `::group::`/`::error|warning|notice file=...::...` workflow
+// commands for every failed query, then a Markdown summary if the runner
+// exposes GITHUB_STEP_SUMMARY.
+func (r *GithubActionsReporter) Report(w io.Writer, summary model.Summary) error {
+	fmt.Fprintf(w, "::notice::Files scanned: %d, Queries failed: %d\n", summary.ScannedFiles, len(summary.FailedQueries))
+
+	for _, q := range summary.FailedQueries {
+		command := severityToWorkflowCommand(q.Severity)
+
+		fmt.Fprintf(w, "::group::%s (%s)\n", q.QueryName, q.Severity)
+		for _, f := range q.Files {
+			fmt.Fprintf(w, "::%s file=%s,line=%d,title=%s::%s\n", command, f.FileName, f.Line, q.QueryName, q.QueryName)
+		}
+		fmt.Fprintln(w, "::endgroup::")
+	}
+
+	if summaryPath := os.Getenv("GITHUB_STEP_SUMMARY"); summaryPath != "" {
+		return writeStepSummary(summaryPath, summary)
+	}
+
+	return nil
+}
+
+// severityToWorkflowCommand maps a kics severity to the GitHub Actions
+// workflow command used to annotate the matching line.
+func severityToWorkflowCommand(severity model.Severity) string {
+	switch severity {
+	case model.SeverityHigh:
+		return "error"
+	case model.SeverityMedium, model.SeverityLow:
+		return "warning"
+	case model.SeverityInfo:
+		return "notice"
+	default:
+		return "warning"
+	}
+}
+
+// writeStepSummary appends a Markdown table of scanned/failed counts and a
+// per-finding list to the job summary file GitHub renders in the run UI.
+func writeStepSummary(path string, summary model.Summary) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fmt.Fprintln(f, "## kics scan results")
+	fmt.Fprintln(f, "")
+	fmt.Fprintln(f, "| Scanned | Failed to scan | Queries loaded | Queries failed |")
+	fmt.Fprintln(f, "| --- | --- | --- | --- |")
+	fmt.Fprintf(f, "| %d | %d | %d | %d |\n", summary.ScannedFiles, summary.FailedToScanFiles, summary.TotalQueries, summary.FailedToExecuteQueries)
+	fmt.Fprintln(f, "")
+
+	if len(summary.FailedQueries) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(f, "| Query | Severity | File | Line |")
+	fmt.Fprintln(f, "| --- | --- | --- | --- |")
+	for _, q := range summary.FailedQueries {
+		for _, file := range q.Files {
+			fmt.Fprintf(f, "| %s | %s | %s | %d |\n", q.QueryName, q.Severity, file.FileName, file.Line)
+		}
+	}
+
+	return nil
+}
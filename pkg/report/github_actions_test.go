@@ -0,0 +1,76 @@
+package report
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/checkmarxDev/ice/pkg/model"
+)
+
+func TestGithubActionsReporterReport(t *testing.T) {
+	summary := model.Summary{
+		ScannedFiles: 2,
+		FailedQueries: []model.FailedQuery{
+			{
+				QueryName: "hardcoded_secret",
+				Severity:  model.SeverityHigh,
+				Files: []model.FileMatch{
+					{FileName: "main.tf", Line: 12},
+				},
+			},
+		},
+	}
+
+	want := "::notice::Files scanned: 2, Queries failed: 1\n" +
+		"::group::hardcoded_secret (HIGH)\n" +
+		"::error file=main.tf,line=12,title=hardcoded_secret::hardcoded_secret\n" +
+		"::endgroup::\n"
+
+	var buf bytes.Buffer
+	if err := (&GithubActionsReporter{}).Report(&buf, summary); err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+
+	if got := buf.String(); got != want {
+		t.Errorf("Report() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestGithubActionsReporterWritesStepSummary(t *testing.T) {
+	summaryPath := filepath.Join(t.TempDir(), "step_summary.md")
+	t.Setenv("GITHUB_STEP_SUMMARY", summaryPath)
+
+	summary := model.Summary{
+		ScannedFiles: 1,
+		FailedQueries: []model.FailedQuery{
+			{
+				QueryName: "hardcoded_secret",
+				Severity:  model.SeverityHigh,
+				Files:     []model.FileMatch{{FileName: "main.tf", Line: 12}},
+			},
+		},
+	}
+
+	if err := (&GithubActionsReporter{}).Report(&bytes.Buffer{}, summary); err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+
+	got, err := os.ReadFile(summaryPath)
+	if err != nil {
+		t.Fatalf("reading step summary: %v", err)
+	}
+
+	want := "## kics scan results\n\n" +
+		"| Scanned | Failed to scan | Queries loaded | Queries failed |\n" +
+		"| --- | --- | --- | --- |\n" +
+		"| 1 | 0 | 0 | 0 |\n\n" +
+		"| Query | Severity | File | Line |\n" +
+		"| --- | --- | --- | --- |\n" +
+		"| hardcoded_secret | HIGH | main.tf | 12 |\n"
+
+	if string(got) != want {
+		t.Errorf("step summary =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,20 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/checkmarxDev/ice/pkg/model"
+)
+
+// JSONReporter renders the summary as indented JSON, mirroring the format
+// written by --output-path.
+type JSONReporter struct{}
+
+// Report encodes summary to w as pretty-printed JSON.
+func (r *JSONReporter) Report(w io.Writer, summary model.Summary) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+
+	return encoder.Encode(summary)
+}
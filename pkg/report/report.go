@@ -0,0 +1,63 @@
+// Package report renders a model.Summary in one of several output formats.
+//
+// It is the integration point for CI usage: callers that only need the
+// exit-code/printed-summary behaviour of the console app can select a
+// reporter by name instead of parsing the `-o` JSON themselves.
+package report
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/checkmarxDev/ice/pkg/model"
+)
+
+// Format identifies a supported reporter.
+type Format string
+
+const (
+	// FormatText renders the classic human-readable summary.
+	FormatText Format = "text"
+	// FormatJSON renders the summary as indented JSON.
+	FormatJSON Format = "json"
+	// FormatSARIF renders SARIF 2.1.0, suitable for GitHub code scanning.
+	FormatSARIF Format = "sarif"
+	// FormatGithubActions streams GitHub Actions workflow commands and,
+	// when available, appends a step summary table.
+	FormatGithubActions Format = "github-actions"
+)
+
+// Reporter writes a model.Summary to w in its own format.
+type Reporter interface {
+	Report(w io.Writer, summary model.Summary) error
+}
+
+// reporters maps the flag value accepted by --format/--reporter to its
+// Reporter implementation.
+var reporters = map[Format]Reporter{
+	FormatText:          &TextReporter{},
+	FormatJSON:          &JSONReporter{},
+	FormatSARIF:         &SARIFReporter{},
+	FormatGithubActions: &GithubActionsReporter{},
+}
+
+// SupportedFormats returns the list of format names accepted by New, in a
+// stable order, for use in flag usage strings.
+func SupportedFormats() []string {
+	return []string{
+		string(FormatText),
+		string(FormatJSON),
+		string(FormatSARIF),
+		string(FormatGithubActions),
+	}
+}
+
+// New looks up the Reporter registered for format.
+func New(format string) (Reporter, error) {
+	reporter, ok := reporters[Format(format)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported report format %q, expected one of %v", format, SupportedFormats())
+	}
+
+	return reporter, nil
+}
@@ -0,0 +1,141 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/checkmarxDev/ice/pkg/model"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+	toolName     = "kics"
+)
+
+// SARIFReporter renders the summary as SARIF 2.1.0, so findings show up as
+// GitHub code-scanning alerts when uploaded via upload-sarif.
+type SARIFReporter struct{}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string                 `json:"id"`
+	Name                 string                 `json:"name"`
+	DefaultConfiguration sarifRuleConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifRuleConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+	Level     string          `json:"level,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// Report encodes summary as a SARIF log with one rule per failed query and
+// one result per matched file.
+func (r *SARIFReporter) Report(w io.Writer, summary model.Summary) error {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name: toolName,
+			},
+		},
+	}
+
+	for _, q := range summary.FailedQueries {
+		level := severityToSARIFLevel(q.Severity)
+
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+			ID:   q.QueryName,
+			Name: q.QueryName,
+			DefaultConfiguration: sarifRuleConfiguration{
+				Level: level,
+			},
+		})
+
+		for _, f := range q.Files {
+			run.Results = append(run.Results, sarifResult{
+				RuleID:  q.QueryName,
+				Message: sarifMessage{Text: q.QueryName},
+				Level:   level,
+				Locations: []sarifLocation{
+					{
+						PhysicalLocation: sarifPhysicalLocation{
+							ArtifactLocation: sarifArtifactLocation{URI: f.FileName},
+							Region:           sarifRegion{StartLine: f.Line},
+						},
+					},
+				},
+			})
+		}
+	}
+
+	out := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+
+	return encoder.Encode(out)
+}
+
+// severityToSARIFLevel maps a kics severity to the SARIF result/rule level.
+func severityToSARIFLevel(severity model.Severity) string {
+	switch severity {
+	case model.SeverityHigh:
+		return "error"
+	case model.SeverityMedium, model.SeverityLow:
+		return "warning"
+	case model.SeverityInfo:
+		return "note"
+	default:
+		return "warning"
+	}
+}
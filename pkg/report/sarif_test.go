@@ -0,0 +1,95 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/checkmarxDev/ice/pkg/model"
+)
+
+func TestSARIFReporterReport(t *testing.T) {
+	summary := model.Summary{
+		FailedQueries: []model.FailedQuery{
+			{
+				QueryName: "hardcoded_secret",
+				Severity:  model.SeverityHigh,
+				Files: []model.FileMatch{
+					{FileName: "main.tf", Line: 12},
+				},
+			},
+		},
+	}
+
+	want := `{
+	"$schema": "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+	"version": "2.1.0",
+	"runs": [
+		{
+			"tool": {
+				"driver": {
+					"name": "kics",
+					"rules": [
+						{
+							"id": "hardcoded_secret",
+							"name": "hardcoded_secret",
+							"defaultConfiguration": {
+								"level": "error"
+							}
+						}
+					]
+				}
+			},
+			"results": [
+				{
+					"ruleId": "hardcoded_secret",
+					"message": {
+						"text": "hardcoded_secret"
+					},
+					"locations": [
+						{
+							"physicalLocation": {
+								"artifactLocation": {
+									"uri": "main.tf"
+								},
+								"region": {
+									"startLine": 12
+								}
+							}
+						}
+					],
+					"level": "error"
+				}
+			]
+		}
+	]
+}
+`
+
+	var buf bytes.Buffer
+	if err := (&SARIFReporter{}).Report(&buf, summary); err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+
+	if got := buf.String(); got != want {
+		t.Errorf("Report() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestSeverityToSARIFLevel(t *testing.T) {
+	tests := []struct {
+		severity model.Severity
+		want     string
+	}{
+		{model.SeverityHigh, "error"},
+		{model.SeverityMedium, "warning"},
+		{model.SeverityLow, "warning"},
+		{model.SeverityInfo, "note"},
+		{model.Severity("unknown"), "warning"},
+	}
+
+	for _, tt := range tests {
+		if got := severityToSARIFLevel(tt.severity); got != tt.want {
+			t.Errorf("severityToSARIFLevel(%q) = %q, want %q", tt.severity, got, tt.want)
+		}
+	}
+}
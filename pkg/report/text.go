@@ -0,0 +1,28 @@
+package report
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/checkmarxDev/ice/pkg/model"
+)
+
+// TextReporter renders the classic human-readable console summary.
+type TextReporter struct{}
+
+// Report writes the scan counters followed by one block per failed query.
+func (r *TextReporter) Report(w io.Writer, summary model.Summary) error {
+	fmt.Fprintf(w, "Files scanned: %d\n", summary.ScannedFiles)
+	fmt.Fprintf(w, "Files failed to scan: %d\n", summary.FailedToScanFiles)
+	fmt.Fprintf(w, "Queries loaded: %d\n", summary.TotalQueries)
+	fmt.Fprintf(w, "Queries failed to execute: %d\n", summary.FailedToExecuteQueries)
+
+	for _, q := range summary.FailedQueries {
+		fmt.Fprintf(w, "%s, Severity: %s, Results: %d\n", q.QueryName, q.Severity, len(q.Files))
+		for _, f := range q.Files {
+			fmt.Fprintf(w, "\t%s:%d\n", f.FileName, f.Line)
+		}
+	}
+
+	return nil
+}